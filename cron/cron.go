@@ -0,0 +1,377 @@
+// Package cron compiles standard cron expressions into
+// recurring.TemporalExpression trees.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/icholy/recurring"
+	"github.com/icholy/recurring/timeutil"
+)
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var weekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+var minuteSpec = numSpec{
+	min: 0, max: 59,
+	value: func(n int) recurring.TemporalExpression { return recurring.MinuteOfHour(n) },
+}
+
+var hourSpec = numSpec{
+	min: 0, max: 23,
+	value: func(n int) recurring.TemporalExpression { return recurring.HourOfDay(n) },
+}
+
+var yearSpec = numSpec{
+	min: 1970, max: 9999,
+	value: func(n int) recurring.TemporalExpression { return recurring.Year(n) },
+	rang:  func(lo, hi int) recurring.TemporalExpression { return recurring.YearRange(lo, hi) },
+}
+
+var monthSpec = numSpec{
+	min: 1, max: 12, names: monthNames,
+	value: func(n int) recurring.TemporalExpression { return recurring.Month(time.Month(n)) },
+	rang: func(lo, hi int) recurring.TemporalExpression {
+		return recurring.MonthRange(time.Month(lo), time.Month(hi))
+	},
+}
+
+var domSpec = numSpec{
+	min: 1, max: 31,
+	value: func(n int) recurring.TemporalExpression { return recurring.Day(n) },
+	rang:  func(lo, hi int) recurring.TemporalExpression { return recurring.DayRange(lo, hi) },
+}
+
+// dowSpec accepts 0-7 since both 0 and 7 mean Sunday in cron.
+var dowSpec = numSpec{
+	min: 0, max: 7, names: weekdayNames,
+	value: func(n int) recurring.TemporalExpression { return recurring.Weekday(time.Weekday(n % 7)) },
+	rang: func(lo, hi int) recurring.TemporalExpression {
+		if hi == 7 {
+			// 7 is Sunday, which sorts before every other weekday
+			return recurring.Or(recurring.WeekdayRange(time.Weekday(lo), time.Saturday), recurring.Weekday(time.Sunday))
+		}
+		return recurring.WeekdayRange(time.Weekday(lo%7), time.Weekday(hi%7))
+	},
+}
+
+// Parse compiles a cron expression into a TemporalExpression. It accepts
+// the standard 5-field form ("minute hour day-of-month month day-of-week")
+// as well as the 6-field EventBridge form, which appends a year field, e.g.
+// "0 9 ? * MON-FRI *".
+func Parse(s string) (recurring.TemporalExpression, error) {
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 5:
+		fields = append(fields, "*")
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron: expected 5 or 6 fields, got %d: %q", len(fields), s)
+	}
+
+	minute, err := minuteSpec.parse(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("cron: minute: %w", err)
+	}
+	hour, err := hourSpec.parse(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("cron: hour: %w", err)
+	}
+	dom, err := parseDayOfMonth(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("cron: day-of-month: %w", err)
+	}
+	month, err := monthSpec.parse(fields[3])
+	if err != nil {
+		return nil, fmt.Errorf("cron: month: %w", err)
+	}
+	dow, err := parseDayOfWeek(fields[4])
+	if err != nil {
+		return nil, fmt.Errorf("cron: day-of-week: %w", err)
+	}
+	year, err := yearSpec.parse(fields[5])
+	if err != nil {
+		return nil, fmt.Errorf("cron: year: %w", err)
+	}
+
+	var ee []recurring.TemporalExpression
+	for _, e := range []recurring.TemporalExpression{minute, hour, month, year} {
+		if e != nil {
+			ee = append(ee, e)
+		}
+	}
+
+	domWild := isWild(fields[2])
+	dowWild := isWild(fields[4])
+	switch {
+	case domWild && dowWild:
+		// no day-of-month/day-of-week constraint at all
+	case domWild:
+		ee = append(ee, dow)
+	case dowWild:
+		ee = append(ee, dom)
+	default:
+		// per Vixie cron semantics, constraining both day-of-month and
+		// day-of-week means "either", not "both"
+		ee = append(ee, recurring.Or(dom, dow))
+	}
+
+	if len(ee) == 0 {
+		return nil, fmt.Errorf("cron: %q matches every moment", s)
+	}
+	return recurring.And(ee...), nil
+}
+
+// NextN parses expr and returns the next n occurrences starting at t,
+// mirroring recurring.NextN.
+func NextN(t time.Time, expr string, n int) ([]time.Time, error) {
+	te, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return recurring.NextN(t, te, n), nil
+}
+
+func isWild(field string) bool {
+	return field == "*" || field == "?"
+}
+
+// numSpec describes how to parse one numeric cron field (optionally with
+// names, e.g. month or day-of-week names) into a TemporalExpression.
+type numSpec struct {
+	min, max int
+	names    map[string]int
+	value    func(n int) recurring.TemporalExpression
+	rang     func(lo, hi int) recurring.TemporalExpression
+}
+
+// parse compiles a whole field, e.g. "1,5-7,*/15", into a TemporalExpression.
+// It returns a nil expression for a wildcard field.
+func (spec numSpec) parse(field string) (recurring.TemporalExpression, error) {
+	if isWild(field) {
+		return nil, nil
+	}
+	var ee []recurring.TemporalExpression
+	for _, part := range strings.Split(field, ",") {
+		e, err := spec.parsePart(part)
+		if err != nil {
+			return nil, err
+		}
+		ee = append(ee, e)
+	}
+	if len(ee) == 1 {
+		return ee[0], nil
+	}
+	return recurring.Or(ee...), nil
+}
+
+// parsePart compiles a single comma-separated part, e.g. "a-b/n" or "*/n"
+// or "a-b" or "a".
+func (spec numSpec) parsePart(part string) (recurring.TemporalExpression, error) {
+	base, step := part, 1
+	if i := strings.IndexByte(part, '/'); i >= 0 {
+		n, err := strconv.Atoi(part[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid step in %q", part)
+		}
+		base, step = part[:i], n
+	}
+	lo, hi := spec.min, spec.max
+	switch {
+	case base == "*":
+	case strings.Contains(base, "-"):
+		bounds := strings.SplitN(base, "-", 2)
+		var err error
+		if lo, err = spec.parseValue(bounds[0]); err != nil {
+			return nil, err
+		}
+		if hi, err = spec.parseValue(bounds[1]); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("invalid range %q: start must not be after end", base)
+		}
+	default:
+		v, err := spec.parseValue(base)
+		if err != nil {
+			return nil, err
+		}
+		lo, hi = v, v
+	}
+	if step == 1 {
+		if lo == hi {
+			return spec.value(lo), nil
+		}
+		if spec.rang != nil {
+			return spec.rang(lo, hi), nil
+		}
+		return spec.values(lo, hi), nil
+	}
+	var ee []recurring.TemporalExpression
+	for v := lo; v <= hi; v += step {
+		ee = append(ee, spec.value(v))
+	}
+	return recurring.Or(ee...), nil
+}
+
+func (spec numSpec) values(lo, hi int) recurring.TemporalExpression {
+	ee := make([]recurring.TemporalExpression, 0, hi-lo+1)
+	for v := lo; v <= hi; v++ {
+		ee = append(ee, spec.value(v))
+	}
+	return recurring.Or(ee...)
+}
+
+func (spec numSpec) parseValue(s string) (int, error) {
+	if spec.names != nil {
+		if v, ok := spec.names[strings.ToUpper(s)]; ok {
+			return v, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < spec.min || v > spec.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, spec.min, spec.max)
+	}
+	return v, nil
+}
+
+// parseDayOfMonth compiles the day-of-month field, including the "L" (last
+// day of month) and "W" (nearest weekday) specials.
+func parseDayOfMonth(field string) (recurring.TemporalExpression, error) {
+	if isWild(field) {
+		return nil, nil
+	}
+	var ee []recurring.TemporalExpression
+	for _, part := range strings.Split(field, ",") {
+		switch {
+		case part == "L":
+			ee = append(ee, recurring.Day(-1))
+		case strings.HasSuffix(part, "W"):
+			base := strings.TrimSuffix(part, "W")
+			day := -1
+			if base != "L" {
+				v, err := strconv.Atoi(base)
+				if err != nil {
+					return nil, fmt.Errorf("invalid day-of-month %q", part)
+				}
+				day = v
+			}
+			ee = append(ee, nearestWeekday(day))
+		default:
+			e, err := domSpec.parsePart(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid day-of-month %q", part)
+			}
+			ee = append(ee, e)
+		}
+	}
+	if len(ee) == 1 {
+		return ee[0], nil
+	}
+	return recurring.Or(ee...), nil
+}
+
+// parseDayOfWeek compiles the day-of-week field, including the "#" (nth
+// weekday of month) special, e.g. "FRI#2".
+func parseDayOfWeek(field string) (recurring.TemporalExpression, error) {
+	if isWild(field) {
+		return nil, nil
+	}
+	var ee []recurring.TemporalExpression
+	for _, part := range strings.Split(field, ",") {
+		if i := strings.IndexByte(part, '#'); i >= 0 {
+			v, err := dowSpec.parseValue(part[:i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid day-of-week %q", part)
+			}
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid day-of-week %q", part)
+			}
+			// the nth occurrence of a weekday always falls on day 7n-6 through 7n
+			ee = append(ee, recurring.And(recurring.Weekday(time.Weekday(v%7)), recurring.DayRange(7*n-6, 7*n)))
+			continue
+		}
+		e, err := dowSpec.parsePart(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid day-of-week %q", part)
+		}
+		ee = append(ee, e)
+	}
+	if len(ee) == 1 {
+		return ee[0], nil
+	}
+	return recurring.Or(ee...), nil
+}
+
+// nearestWeekday matches the weekday on or nearest to the given day of the
+// month, the way cron's "W" flag does, without crossing into the next or
+// previous month. A negative day counts back from the end of the month,
+// the same convention recurring.Day uses.
+type nearestWeekday int
+
+func (d nearestWeekday) normalize(t time.Time) int {
+	day := int(d)
+	if day < 0 {
+		day = timeutil.EndOfMonth(t).Day() + day + 1
+	}
+	return day
+}
+
+func (d nearestWeekday) dayFor(t time.Time) int {
+	last := timeutil.EndOfMonth(t).Day()
+	day := d.normalize(t)
+	if day > last {
+		day = last
+	}
+	target := time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location())
+	switch target.Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+// Includes returns true when the provided time's day is the nearest
+// weekday to the expression's day of the month
+func (d nearestWeekday) Includes(t time.Time) bool {
+	return t.Day() == d.dayFor(t)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (d nearestWeekday) Next(t, max time.Time) time.Time {
+	for !t.After(max) {
+		if d.Includes(t) {
+			return t
+		}
+		t = t.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// Granularity returns 24 hours, since a nearestWeekday only matches whole days
+func (d nearestWeekday) Granularity() time.Duration {
+	return 24 * time.Hour
+}
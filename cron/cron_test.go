@@ -0,0 +1,129 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestParseIncludes(t *testing.T) {
+
+	// yyyy/mm/dd 15:04
+	layout := "2006/01/02 15:04"
+
+	tests := []struct {
+		name    string
+		cron    string
+		match   []string
+		nomatch []string
+	}{
+		{
+			name:    "every weekday at 09:00",
+			cron:    "0 9 * * MON-FRI",
+			match:   []string{"2018/10/01 09:00", "2018/10/05 09:00"},
+			nomatch: []string{"2018/10/01 09:01", "2018/10/06 09:00", "2018/10/07 09:00"},
+		},
+		{
+			name:    "EventBridge form with explicit year",
+			cron:    "0 9 ? * MON-FRI *",
+			match:   []string{"2018/10/01 09:00"},
+			nomatch: []string{"2018/10/06 09:00"},
+		},
+		{
+			name:    "step minutes",
+			cron:    "*/15 * * * *",
+			match:   []string{"2018/10/01 09:00", "2018/10/01 09:15", "2018/10/01 09:45"},
+			nomatch: []string{"2018/10/01 09:05", "2018/10/01 09:20"},
+		},
+		{
+			name:    "last day of month",
+			cron:    "0 0 L * *",
+			match:   []string{"2018/09/30 00:00", "2018/02/28 00:00"},
+			nomatch: []string{"2018/09/29 00:00", "2018/10/30 00:00"},
+		},
+		{
+			name:    "nearest weekday to the 1st",
+			cron:    "0 0 1W * *",
+			match:   []string{"2022/10/03 00:00"}, // Oct 1 2022 is a Saturday
+			nomatch: []string{"2022/10/01 00:00", "2022/10/04 00:00"},
+		},
+		{
+			name:    "2nd Friday of the month",
+			cron:    "0 0 * * FRI#2",
+			match:   []string{"2018/10/12 00:00"},
+			nomatch: []string{"2018/10/05 00:00", "2018/10/19 00:00"},
+		},
+		{
+			name:    "day-of-month or day-of-week",
+			cron:    "0 0 1 * MON",
+			match:   []string{"2018/10/01 00:00", "2018/10/08 00:00"},
+			nomatch: []string{"2018/10/02 00:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.cron)
+			assert.NilError(t, err)
+			for _, s := range tt.match {
+				mt, err := time.Parse(layout, s)
+				assert.NilError(t, err)
+				assert.Assert(t, expr.Includes(mt), s)
+			}
+			for _, s := range tt.nomatch {
+				mt, err := time.Parse(layout, s)
+				assert.NilError(t, err)
+				assert.Assert(t, !expr.Includes(mt), s)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"0 9 * *",
+		"60 9 * * *",
+		"0 9 * * XYZ",
+		"0 22-2 * * *",
+		"0 9 31-5 * *",
+	}
+	for _, cron := range tests {
+		t.Run(cron, func(t *testing.T) {
+			_, err := Parse(cron)
+			assert.Assert(t, err != nil)
+		})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	start, err := time.Parse("2006/01/02 15:04", "2018/10/01 00:00")
+	assert.NilError(t, err)
+
+	tt, err := NextN(start, "0 9 * * MON-FRI", 3)
+	assert.NilError(t, err)
+	assert.Equal(t, len(tt), 3)
+	assert.Equal(t, tt[0].Format("2006/01/02 15:04"), "2018/10/01 09:00")
+	assert.Equal(t, tt[1].Format("2006/01/02 15:04"), "2018/10/02 09:00")
+	assert.Equal(t, tt[2].Format("2006/01/02 15:04"), "2018/10/03 09:00")
+}
+
+// TestNextNUnsatisfiable guards against NextN hanging on a cron expression
+// that can never match, e.g. Feb 30th
+func TestNextNUnsatisfiable(t *testing.T) {
+	start, err := time.Parse("2006/01/02 15:04", "2018/10/01 00:00")
+	assert.NilError(t, err)
+
+	done := make(chan []time.Time, 1)
+	go func() {
+		tt, err := NextN(start, "0 0 30 2 *", 1)
+		assert.NilError(t, err)
+		done <- tt
+	}()
+	select {
+	case tt := <-done:
+		assert.Equal(t, len(tt), 0)
+	case <-time.After(2 * time.Second):
+		t.Fatal("NextN did not terminate")
+	}
+}
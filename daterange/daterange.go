@@ -0,0 +1,219 @@
+// Package daterange parses human-friendly, relative or absolute date
+// range expressions (e.g. "this month", "2018-01-01..2018-02-01", "2w 3d")
+// into recurring.TemporalExpression values.
+package daterange
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/icholy/recurring"
+	"github.com/icholy/recurring/timeutil"
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var monthNames = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var absoluteDateRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+var quantityRe = regexp.MustCompile(`^(\d+\s*[a-z]+)(\s+\d+\s*[a-z]+)*$`)
+var quantityTokenRe = regexp.MustCompile(`(\d+)\s*([a-z]+)`)
+
+// now is overridden in tests so relative terms are deterministic.
+var now = time.Now
+
+// Parse interprets s as a date range expression and returns its bounds
+// along with an equivalent TemporalExpression. Either side of a ".."
+// range may be omitted for an open-ended range.
+func Parse(s string) (start, end time.Time, expr recurring.TemporalExpression, err error) {
+	s = normalize(s)
+	if s == "" {
+		return time.Time{}, time.Time{}, nil, fmt.Errorf("daterange: empty expression")
+	}
+	if i := strings.Index(s, ".."); i >= 0 {
+		left, right := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+2:])
+		if left == "" && right == "" {
+			return time.Time{}, time.Time{}, nil, fmt.Errorf("daterange: range needs a start or end: %q", s)
+		}
+		if left != "" {
+			if start, _, err = resolveTerm(left); err != nil {
+				return time.Time{}, time.Time{}, nil, err
+			}
+		}
+		if right != "" {
+			if _, end, err = resolveTerm(right); err != nil {
+				return time.Time{}, time.Time{}, nil, err
+			}
+		}
+	} else {
+		if start, end, err = resolveTerm(s); err != nil {
+			return time.Time{}, time.Time{}, nil, err
+		}
+	}
+	return start, end, buildExpr(start, end), nil
+}
+
+// buildExpr returns a DateRangeExpression covering [start, end], treating
+// a zero start or end as open-ended
+func buildExpr(start, end time.Time) recurring.TemporalExpression {
+	if start.IsZero() {
+		start = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if end.IsZero() {
+		end = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return recurring.DateRange(start, end)
+}
+
+// normalize lowercases s and collapses underscores/whitespace into single spaces
+func normalize(s string) string {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, "_", " ")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// resolveTerm translates a single (already normalized) term into the
+// start and end of the period it describes
+func resolveTerm(term string) (start, end time.Time, err error) {
+	switch {
+	case absoluteDateRe.MatchString(term):
+		d, err := time.Parse("2006-01-02", term)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("daterange: invalid date %q: %w", term, err)
+		}
+		return timeutil.BeginningOfDay(d), timeutil.EndOfDay(d), nil
+
+	case term == "today":
+		return dayBounds(now())
+	case term == "yesterday":
+		return dayBounds(now().AddDate(0, 0, -1))
+	case term == "tomorrow":
+		return dayBounds(now().AddDate(0, 0, 1))
+
+	case strings.HasPrefix(term, "this ") || strings.HasPrefix(term, "last ") || strings.HasPrefix(term, "next "):
+		return resolvePeriod(term)
+	}
+
+	if wd, ok := weekdayNames[term]; ok {
+		return dayBounds(nextWeekday(now(), wd))
+	}
+	if m, ok := monthNames[term]; ok {
+		anchor := time.Date(now().Year(), m, 1, 0, 0, 0, 0, now().Location())
+		return timeutil.BeginningOfMonth(anchor), timeutil.EndOfMonth(anchor), nil
+	}
+	if quantityRe.MatchString(term) {
+		return resolveQuantity(term)
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("daterange: unrecognized term %q", term)
+}
+
+func dayBounds(t time.Time) (time.Time, time.Time, error) {
+	return timeutil.BeginningOfDay(t), timeutil.EndOfDay(t), nil
+}
+
+// nextWeekday returns the next occurrence of wd on or after t
+func nextWeekday(t time.Time, wd time.Weekday) time.Time {
+	for t.Weekday() != wd {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// resolvePeriod handles "this|last|next week|month|quarter|year"
+func resolvePeriod(term string) (time.Time, time.Time, error) {
+	fields := strings.Fields(term)
+	if len(fields) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("daterange: unrecognized term %q", term)
+	}
+	which, unit := fields[0], fields[1]
+	anchor := now()
+	switch unit {
+	case "week":
+		switch which {
+		case "last":
+			anchor = anchor.AddDate(0, 0, -7)
+		case "next":
+			anchor = anchor.AddDate(0, 0, 7)
+		}
+		return timeutil.BeginningOfWeek(anchor), timeutil.EndOfWeek(anchor), nil
+	case "month":
+		switch which {
+		case "last":
+			anchor = anchor.AddDate(0, -1, 0)
+		case "next":
+			anchor = anchor.AddDate(0, 1, 0)
+		}
+		return timeutil.BeginningOfMonth(anchor), timeutil.EndOfMonth(anchor), nil
+	case "quarter":
+		switch which {
+		case "last":
+			anchor = anchor.AddDate(0, -3, 0)
+		case "next":
+			anchor = anchor.AddDate(0, 3, 0)
+		}
+		return timeutil.BeginningOfQuarter(anchor), timeutil.EndOfQuarter(anchor), nil
+	case "year":
+		switch which {
+		case "last":
+			anchor = anchor.AddDate(-1, 0, 0)
+		case "next":
+			anchor = anchor.AddDate(1, 0, 0)
+		}
+		return timeutil.BeginningOfYear(anchor), timeutil.EndOfYear(anchor), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("daterange: unrecognized term %q", term)
+}
+
+// resolveQuantity handles offsets like "2w 3d" or "1 month 5 days",
+// resolved relative to now
+func resolveQuantity(term string) (time.Time, time.Time, error) {
+	matches := quantityTokenRe.FindAllStringSubmatch(term, -1)
+	var days, months, years int
+	for _, m := range matches {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("daterange: invalid quantity %q", term)
+		}
+		switch strings.TrimSuffix(m[2], "s") {
+		case "d", "day":
+			days += n
+		case "w", "week":
+			days += n * 7
+		case "mo", "mon", "month":
+			months += n
+		case "y", "yr", "year":
+			years += n
+		default:
+			return time.Time{}, time.Time{}, fmt.Errorf("daterange: unrecognized unit in %q", term)
+		}
+	}
+	start := now()
+	end := start.AddDate(years, months, days)
+	return timeutil.BeginningOfDay(start), timeutil.EndOfDay(end), nil
+}
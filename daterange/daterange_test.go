@@ -0,0 +1,141 @@
+package daterange
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func withNow(t time.Time, fn func()) {
+	old := now
+	now = func() time.Time { return t }
+	defer func() { now = old }()
+	fn()
+}
+
+func TestParse(t *testing.T) {
+
+	// yyyy-mm-dd
+	layout := "2006-01-02"
+	fixed := mustParse(layout, "2018-10-15") // a Monday
+
+	tests := []struct {
+		name    string
+		input   string
+		start   string
+		end     string
+		wantErr bool
+	}{
+		{
+			name:  "absolute range",
+			input: "2018-01-01..2018-02-01",
+			start: "2018-01-01",
+			end:   "2018-02-01",
+		},
+		{
+			name:  "single absolute date",
+			input: "2018-01-01",
+			start: "2018-01-01",
+			end:   "2018-01-01",
+		},
+		{
+			name:  "today",
+			input: "today",
+			start: "2018-10-15",
+			end:   "2018-10-15",
+		},
+		{
+			name:  "yesterday",
+			input: "yesterday",
+			start: "2018-10-14",
+			end:   "2018-10-14",
+		},
+		{
+			name:  "tomorrow",
+			input: "tomorrow",
+			start: "2018-10-16",
+			end:   "2018-10-16",
+		},
+		{
+			name:  "this month",
+			input: "this month",
+			start: "2018-10-01",
+			end:   "2018-10-31",
+		},
+		{
+			name:  "last month",
+			input: "last_month",
+			start: "2018-09-01",
+			end:   "2018-09-30",
+		},
+		{
+			name:  "next year",
+			input: "NEXT YEAR",
+			start: "2019-01-01",
+			end:   "2019-12-31",
+		},
+		{
+			name:  "weekday name",
+			input: "friday",
+			start: "2018-10-19",
+			end:   "2018-10-19",
+		},
+		{
+			name:  "month name",
+			input: "march",
+			start: "2018-03-01",
+			end:   "2018-03-31",
+		},
+		{
+			name:  "quantity",
+			input: "2w 3d",
+			start: "2018-10-15",
+			end:   "2018-11-01",
+		},
+		{
+			name:    "unrecognized",
+			input:   "whenever",
+			wantErr: true,
+		},
+		{
+			name:    "empty range",
+			input:   "..",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withNow(fixed, func() {
+				start, end, expr, err := Parse(tt.input)
+				if tt.wantErr {
+					assert.Assert(t, err != nil)
+					return
+				}
+				assert.NilError(t, err)
+				assert.Equal(t, start.Format(layout), tt.start)
+				assert.Equal(t, end.Format(layout), tt.end)
+				assert.Assert(t, expr.Includes(start))
+				assert.Assert(t, expr.Includes(end))
+			})
+		})
+	}
+}
+
+func TestParseOpenEnded(t *testing.T) {
+	start, end, expr, err := Parse("2018-01-01..")
+	assert.NilError(t, err)
+	assert.Equal(t, start.Format("2006-01-02"), "2018-01-01")
+	assert.Assert(t, end.IsZero())
+	assert.Assert(t, expr.Includes(mustParse("2006-01-02", "2050-01-01")))
+	assert.Assert(t, !expr.Includes(mustParse("2006-01-02", "2017-12-31")))
+}
+
+func mustParse(layout, s string) time.Time {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
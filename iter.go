@@ -0,0 +1,53 @@
+package recurring
+
+import (
+	"iter"
+	"time"
+)
+
+// farFuture bounds otherwise-unbounded iteration, so Next can report
+// "no more occurrences" instead of searching forever
+var farFuture = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// Iter returns a lazy sequence of te's occurrences on or after start. It
+// stops once te has no further occurrences before farFuture
+func Iter(start time.Time, te TemporalExpression) iter.Seq[time.Time] {
+	return Between(start, farFuture, te)
+}
+
+// Between returns a lazy sequence of te's occurrences in [start, end]
+func Between(start, end time.Time, te TemporalExpression) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		g := te.Granularity()
+		t := start
+		for {
+			next := te.Next(t, end)
+			if next.IsZero() {
+				return
+			}
+			if !yield(next) {
+				return
+			}
+			t = next.Add(g)
+		}
+	}
+}
+
+// Take returns a sequence yielding at most the first n values from seq
+func Take(n int, seq iter.Seq[time.Time]) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for t := range seq {
+			if !yield(t) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestIter(t *testing.T) {
+	layout := "2006/01/02"
+	start := mustParseDate(layout, "2018/01/01")
+
+	var got []string
+	for tm := range Take(3, Iter(start, Day(15))) {
+		got = append(got, tm.Format(layout))
+	}
+	assert.DeepEqual(t, got, []string{"2018/01/15", "2018/02/15", "2018/03/15"})
+}
+
+func TestIterStopsEarly(t *testing.T) {
+	layout := "2006/01/02"
+	start := mustParseDate(layout, "2018/01/01")
+
+	var got []string
+	for tm := range Iter(start, Day(15)) {
+		got = append(got, tm.Format(layout))
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.DeepEqual(t, got, []string{"2018/01/15", "2018/02/15"})
+}
+
+func TestBetween(t *testing.T) {
+	layout := "2006/01/02"
+	start := mustParseDate(layout, "2018/01/01")
+	end := mustParseDate(layout, "2018/03/01")
+
+	var got []string
+	for tm := range Between(start, end, Day(15)) {
+		got = append(got, tm.Format(layout))
+	}
+	assert.DeepEqual(t, got, []string{"2018/01/15", "2018/02/15"})
+}
+
+func TestTakeMoreThanAvailable(t *testing.T) {
+	layout := "2006/01/02"
+	start := mustParseDate(layout, "2018/01/01")
+	end := mustParseDate(layout, "2018/02/01")
+
+	var got []time.Time
+	for tm := range Take(5, Between(start, end, Day(15))) {
+		got = append(got, tm)
+	}
+	assert.Equal(t, len(got), 1)
+}
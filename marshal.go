@@ -0,0 +1,902 @@
+package recurring
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// envelope is the discriminator-tagged wrapper used to serialize a
+// TemporalExpression of unknown concrete type. Type identifies which
+// concrete type Data was encoded from, so Unmarshal knows how to decode it.
+type envelope struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// toEnvelope encodes te's concrete type into an envelope
+func toEnvelope(te TemporalExpression) (envelope, error) {
+	name, err := typeName(te)
+	if err != nil {
+		return envelope{}, err
+	}
+	data, err := json.Marshal(te)
+	if err != nil {
+		return envelope{}, err
+	}
+	return envelope{Type: name, Data: data}, nil
+}
+
+// fromEnvelope decodes env.Data into the concrete type identified by env.Type
+func fromEnvelope(env envelope) (TemporalExpression, error) {
+	switch env.Type {
+	case "Day":
+		var v Day
+		return v, json.Unmarshal(env.Data, &v)
+	case "DayRange":
+		var v DayRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Week":
+		var v WeekExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "WeekRange":
+		var v WeekRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Weekday":
+		var v Weekday
+		return v, json.Unmarshal(env.Data, &v)
+	case "WeekdayRange":
+		var v WeekdayRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Month":
+		var v Month
+		return v, json.Unmarshal(env.Data, &v)
+	case "MonthRange":
+		var v MonthRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Year":
+		var v Year
+		return v, json.Unmarshal(env.Data, &v)
+	case "YearRange":
+		var v YearRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Date":
+		var v Date
+		return v, json.Unmarshal(env.Data, &v)
+	case "DateRange":
+		var v DateRangeExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Or":
+		var v OrExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "And":
+		var v AndExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "Not":
+		var v NotExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "HourOfDay":
+		var v HourOfDay
+		return v, json.Unmarshal(env.Data, &v)
+	case "MinuteOfHour":
+		var v MinuteOfHour
+		return v, json.Unmarshal(env.Data, &v)
+	case "EveryNDays":
+		var v EveryNDaysExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "EveryNWeeks":
+		var v EveryNWeeksExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "EveryNMonths":
+		var v EveryNMonthsExpression
+		return v, json.Unmarshal(env.Data, &v)
+	case "EveryNYears":
+		var v EveryNYearsExpression
+		return v, json.Unmarshal(env.Data, &v)
+	default:
+		return nil, fmt.Errorf("recurring: unknown expression type %q", env.Type)
+	}
+}
+
+// typeName returns the discriminator string identifying te's concrete type
+func typeName(te TemporalExpression) (string, error) {
+	switch te.(type) {
+	case Day:
+		return "Day", nil
+	case DayRangeExpression:
+		return "DayRange", nil
+	case WeekExpression:
+		return "Week", nil
+	case WeekRangeExpression:
+		return "WeekRange", nil
+	case Weekday:
+		return "Weekday", nil
+	case WeekdayRangeExpression:
+		return "WeekdayRange", nil
+	case Month:
+		return "Month", nil
+	case MonthRangeExpression:
+		return "MonthRange", nil
+	case Year:
+		return "Year", nil
+	case YearRangeExpression:
+		return "YearRange", nil
+	case Date:
+		return "Date", nil
+	case DateRangeExpression:
+		return "DateRange", nil
+	case OrExpression:
+		return "Or", nil
+	case AndExpression:
+		return "And", nil
+	case NotExpression:
+		return "Not", nil
+	case HourOfDay:
+		return "HourOfDay", nil
+	case MinuteOfHour:
+		return "MinuteOfHour", nil
+	case EveryNDaysExpression:
+		return "EveryNDays", nil
+	case EveryNWeeksExpression:
+		return "EveryNWeeks", nil
+	case EveryNMonthsExpression:
+		return "EveryNMonths", nil
+	case EveryNYearsExpression:
+		return "EveryNYears", nil
+	default:
+		return "", fmt.Errorf("recurring: cannot marshal expression of type %T", te)
+	}
+}
+
+// Marshal encodes te as JSON, tagging it with its concrete type so that
+// Unmarshal can reconstruct the same TemporalExpression from the bytes
+func Marshal(te TemporalExpression) ([]byte, error) {
+	env, err := toEnvelope(te)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(env)
+}
+
+// Unmarshal decodes a TemporalExpression previously encoded with Marshal
+func Unmarshal(data []byte) (TemporalExpression, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	return fromEnvelope(env)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying day number.
+// Defined explicitly (rather than left to reflection) so it takes priority
+// over MarshalText, which would otherwise recurse through Marshal
+func (d Day) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(d))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Day) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*d = Day(v)
+	return nil
+}
+
+// dayRangeJSON mirrors DayRangeExpression's fields without its methods, so
+// it can be marshaled/unmarshaled by reflection without recursing through
+// DayRangeExpression's own MarshalJSON
+type dayRangeJSON DayRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (dr DayRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dayRangeJSON(dr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (dr *DayRangeExpression) UnmarshalJSON(data []byte) error {
+	var v dayRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*dr = DayRangeExpression(v)
+	return nil
+}
+
+// weekJSON mirrors WeekExpression's fields without its methods, so it can be
+// marshaled/unmarshaled by reflection without recursing through
+// WeekExpression's own MarshalJSON
+type weekJSON WeekExpression
+
+// MarshalJSON implements json.Marshaler
+func (w WeekExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(weekJSON(w))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (w *WeekExpression) UnmarshalJSON(data []byte) error {
+	var v weekJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*w = WeekExpression(v)
+	return nil
+}
+
+// weekRangeJSON mirrors WeekRangeExpression's fields without its methods, so
+// it can be marshaled/unmarshaled by reflection without recursing through
+// WeekRangeExpression's own MarshalJSON
+type weekRangeJSON WeekRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (wr WeekRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(weekRangeJSON(wr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (wr *WeekRangeExpression) UnmarshalJSON(data []byte) error {
+	var v weekRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*wr = WeekRangeExpression(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying weekday
+// number. Defined explicitly so it takes priority over MarshalText, which
+// would otherwise recurse through Marshal
+func (wd Weekday) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(wd))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (wd *Weekday) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*wd = Weekday(v)
+	return nil
+}
+
+// weekdayRangeJSON mirrors WeekdayRangeExpression's fields without its
+// methods, so it can be marshaled/unmarshaled by reflection without
+// recursing through WeekdayRangeExpression's own MarshalJSON
+type weekdayRangeJSON WeekdayRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (wr WeekdayRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(weekdayRangeJSON(wr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (wr *WeekdayRangeExpression) UnmarshalJSON(data []byte) error {
+	var v weekdayRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*wr = WeekdayRangeExpression(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying month
+// number. Defined explicitly so it takes priority over MarshalText, which
+// would otherwise recurse through Marshal
+func (m Month) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(m))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *Month) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Month(v)
+	return nil
+}
+
+// monthRangeJSON mirrors MonthRangeExpression's fields without its methods,
+// so it can be marshaled/unmarshaled by reflection without recursing
+// through MonthRangeExpression's own MarshalJSON
+type monthRangeJSON MonthRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (mr MonthRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(monthRangeJSON(mr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (mr *MonthRangeExpression) UnmarshalJSON(data []byte) error {
+	var v monthRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*mr = MonthRangeExpression(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying year
+// number. Defined explicitly so it takes priority over MarshalText, which
+// would otherwise recurse through Marshal
+func (y Year) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(y))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (y *Year) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*y = Year(v)
+	return nil
+}
+
+// yearRangeJSON mirrors YearRangeExpression's fields without its methods,
+// so it can be marshaled/unmarshaled by reflection without recursing
+// through YearRangeExpression's own MarshalJSON
+type yearRangeJSON YearRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (yr YearRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(yearRangeJSON(yr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (yr *YearRangeExpression) UnmarshalJSON(data []byte) error {
+	var v yearRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*yr = YearRangeExpression(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying date as RFC
+// 3339 text
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(d))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var t time.Time
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*d = Date(t)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding each underlying expression
+// as a discriminator-tagged envelope so Unmarshal can reconstruct them
+func (oe OrExpression) MarshalJSON() ([]byte, error) {
+	return marshalExpressions(oe.ee)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (oe *OrExpression) UnmarshalJSON(data []byte) error {
+	ee, err := unmarshalExpressions(data)
+	if err != nil {
+		return err
+	}
+	oe.ee = ee
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding each underlying expression
+// as a discriminator-tagged envelope so Unmarshal can reconstruct them
+func (ae AndExpression) MarshalJSON() ([]byte, error) {
+	return marshalExpressions(ae.ee)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (ae *AndExpression) UnmarshalJSON(data []byte) error {
+	ee, err := unmarshalExpressions(data)
+	if err != nil {
+		return err
+	}
+	ae.ee = ee
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying expression
+// as a discriminator-tagged envelope so Unmarshal can reconstruct it
+func (ne NotExpression) MarshalJSON() ([]byte, error) {
+	env, err := toEnvelope(ne.e)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(env)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (ne *NotExpression) UnmarshalJSON(data []byte) error {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return err
+	}
+	e, err := fromEnvelope(env)
+	if err != nil {
+		return err
+	}
+	ne.e = e
+	return nil
+}
+
+// dateRangeJSON mirrors DateRangeExpression's fields without its methods,
+// so it can be marshaled/unmarshaled by reflection without recursing
+// through DateRangeExpression's own MarshalJSON
+type dateRangeJSON DateRangeExpression
+
+// MarshalJSON implements json.Marshaler
+func (dr DateRangeExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateRangeJSON(dr))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (dr *DateRangeExpression) UnmarshalJSON(data []byte) error {
+	var v dateRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*dr = DateRangeExpression(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying hour.
+// Defined explicitly so it takes priority over MarshalText, which would
+// otherwise recurse through Marshal
+func (h HourOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(h))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (h *HourOfDay) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*h = HourOfDay(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the underlying minute.
+// Defined explicitly so it takes priority over MarshalText, which would
+// otherwise recurse through Marshal
+func (m MinuteOfHour) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(m))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *MinuteOfHour) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = MinuteOfHour(v)
+	return nil
+}
+
+// everyNDaysJSON mirrors EveryNDaysExpression's fields without its methods,
+// so it can be marshaled/unmarshaled by reflection without recursing
+// through EveryNDaysExpression's own MarshalJSON
+type everyNDaysJSON EveryNDaysExpression
+
+// MarshalJSON implements json.Marshaler
+func (e EveryNDaysExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(everyNDaysJSON(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *EveryNDaysExpression) UnmarshalJSON(data []byte) error {
+	var v everyNDaysJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EveryNDaysExpression(v)
+	return nil
+}
+
+// everyNWeeksJSON mirrors EveryNWeeksExpression's fields without its
+// methods, so it can be marshaled/unmarshaled by reflection without
+// recursing through EveryNWeeksExpression's own MarshalJSON
+type everyNWeeksJSON EveryNWeeksExpression
+
+// MarshalJSON implements json.Marshaler
+func (e EveryNWeeksExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(everyNWeeksJSON(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *EveryNWeeksExpression) UnmarshalJSON(data []byte) error {
+	var v everyNWeeksJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EveryNWeeksExpression(v)
+	return nil
+}
+
+// everyNMonthsJSON mirrors EveryNMonthsExpression's fields without its
+// methods, so it can be marshaled/unmarshaled by reflection without
+// recursing through EveryNMonthsExpression's own MarshalJSON
+type everyNMonthsJSON EveryNMonthsExpression
+
+// MarshalJSON implements json.Marshaler
+func (e EveryNMonthsExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(everyNMonthsJSON(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *EveryNMonthsExpression) UnmarshalJSON(data []byte) error {
+	var v everyNMonthsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EveryNMonthsExpression(v)
+	return nil
+}
+
+// everyNYearsJSON mirrors EveryNYearsExpression's fields without its
+// methods, so it can be marshaled/unmarshaled by reflection without
+// recursing through EveryNYearsExpression's own MarshalJSON
+type everyNYearsJSON EveryNYearsExpression
+
+// MarshalJSON implements json.Marshaler
+func (e EveryNYearsExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(everyNYearsJSON(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *EveryNYearsExpression) UnmarshalJSON(data []byte) error {
+	var v everyNYearsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EveryNYearsExpression(v)
+	return nil
+}
+
+// marshalExpressions encodes ee as a JSON array of discriminator-tagged
+// envelopes, one per expression
+func marshalExpressions(ee []TemporalExpression) ([]byte, error) {
+	envs := make([]envelope, len(ee))
+	for i, e := range ee {
+		env, err := toEnvelope(e)
+		if err != nil {
+			return nil, err
+		}
+		envs[i] = env
+	}
+	return json.Marshal(envs)
+}
+
+// unmarshalExpressions decodes a JSON array produced by marshalExpressions
+func unmarshalExpressions(data []byte) ([]TemporalExpression, error) {
+	var envs []envelope
+	if err := json.Unmarshal(data, &envs); err != nil {
+		return nil, err
+	}
+	ee := make([]TemporalExpression, len(envs))
+	for i, env := range envs {
+		e, err := fromEnvelope(env)
+		if err != nil {
+			return nil, err
+		}
+		ee[i] = e
+	}
+	return ee, nil
+}
+
+// unmarshalText decodes text produced by MarshalText back into T, the
+// concrete type text was marshaled from
+func unmarshalText[T TemporalExpression](text []byte) (T, error) {
+	var zero T
+	te, err := Unmarshal(text)
+	if err != nil {
+		return zero, err
+	}
+	v, ok := te.(T)
+	if !ok {
+		return zero, fmt.Errorf("recurring: unmarshaled %T, want %T", te, zero)
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (d Day) MarshalText() ([]byte, error) { return Marshal(d) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Day) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[Day](text)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (dr DayRangeExpression) MarshalText() ([]byte, error) { return Marshal(dr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (dr *DayRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[DayRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*dr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (w WeekExpression) MarshalText() ([]byte, error) { return Marshal(w) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (w *WeekExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[WeekExpression](text)
+	if err != nil {
+		return err
+	}
+	*w = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (wr WeekRangeExpression) MarshalText() ([]byte, error) { return Marshal(wr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (wr *WeekRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[WeekRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*wr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (wd Weekday) MarshalText() ([]byte, error) { return Marshal(wd) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (wd *Weekday) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[Weekday](text)
+	if err != nil {
+		return err
+	}
+	*wd = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (wr WeekdayRangeExpression) MarshalText() ([]byte, error) { return Marshal(wr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (wr *WeekdayRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[WeekdayRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*wr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (m Month) MarshalText() ([]byte, error) { return Marshal(m) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (m *Month) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[Month](text)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (mr MonthRangeExpression) MarshalText() ([]byte, error) { return Marshal(mr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (mr *MonthRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[MonthRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*mr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (y Year) MarshalText() ([]byte, error) { return Marshal(y) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (y *Year) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[Year](text)
+	if err != nil {
+		return err
+	}
+	*y = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (yr YearRangeExpression) MarshalText() ([]byte, error) { return Marshal(yr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (yr *YearRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[YearRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*yr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (d Date) MarshalText() ([]byte, error) { return Marshal(d) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Date) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[Date](text)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (dr DateRangeExpression) MarshalText() ([]byte, error) { return Marshal(dr) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (dr *DateRangeExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[DateRangeExpression](text)
+	if err != nil {
+		return err
+	}
+	*dr = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (oe OrExpression) MarshalText() ([]byte, error) { return Marshal(oe) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (oe *OrExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[OrExpression](text)
+	if err != nil {
+		return err
+	}
+	*oe = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (ae AndExpression) MarshalText() ([]byte, error) { return Marshal(ae) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (ae *AndExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[AndExpression](text)
+	if err != nil {
+		return err
+	}
+	*ae = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (ne NotExpression) MarshalText() ([]byte, error) { return Marshal(ne) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (ne *NotExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[NotExpression](text)
+	if err != nil {
+		return err
+	}
+	*ne = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (h HourOfDay) MarshalText() ([]byte, error) { return Marshal(h) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (h *HourOfDay) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[HourOfDay](text)
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (m MinuteOfHour) MarshalText() ([]byte, error) { return Marshal(m) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (m *MinuteOfHour) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[MinuteOfHour](text)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (e EveryNDaysExpression) MarshalText() ([]byte, error) { return Marshal(e) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (e *EveryNDaysExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[EveryNDaysExpression](text)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (e EveryNWeeksExpression) MarshalText() ([]byte, error) { return Marshal(e) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (e *EveryNWeeksExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[EveryNWeeksExpression](text)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (e EveryNMonthsExpression) MarshalText() ([]byte, error) { return Marshal(e) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (e *EveryNMonthsExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[EveryNMonthsExpression](text)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// discriminator-tagged encoding as Marshal
+func (e EveryNYearsExpression) MarshalText() ([]byte, error) { return Marshal(e) }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (e *EveryNYearsExpression) UnmarshalText(text []byte) error {
+	v, err := unmarshalText[EveryNYearsExpression](text)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
@@ -0,0 +1,101 @@
+package recurring
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+// marshalTestCases covers every concrete TemporalExpression type, reused by
+// the JSON and text marshaling tests below
+func marshalTestCases() []struct {
+	name string
+	expr TemporalExpression
+} {
+	date := Date(mustParseDate("2006/01/02", "2012/01/01"))
+	return []struct {
+		name string
+		expr TemporalExpression
+	}{
+		{"Day", Day(5)},
+		{"DayRange", DayRange(1, 15)},
+		{"Week", Week(2)},
+		{"WeekRange", WeekRange(1, 2)},
+		{"Weekday", Monday},
+		{"WeekdayRange", WeekdayRange(time.Monday, time.Friday)},
+		{"Month", January},
+		{"MonthRange", MonthRange(time.January, time.March)},
+		{"Year", Year(2012)},
+		{"YearRange", YearRange(2012, 2014)},
+		{"Date", date},
+		{"DateRange", DateRange(time.Time(date), time.Time(date).AddDate(0, 0, 7))},
+		{"HourOfDay", HourOfDay(9)},
+		{"MinuteOfHour", MinuteOfHour(30)},
+		{"EveryNDays", EveryNDays(time.Time(date), 3)},
+		{"EveryNWeeks", EveryNWeeks(time.Time(date), 2)},
+		{"EveryNMonths", EveryNMonths(time.Time(date), 2)},
+		{"EveryNYears", EveryNYears(time.Time(date), 4)},
+		{"Or", Or(Day(1), Day(15))},
+		{"And", And(Month(January), Day(1))},
+		{"Not", Not(Weekday(time.Sunday))},
+		{"Nested", Or(And(Month(January), Day(1)), Not(Weekday(time.Sunday)))},
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	for _, tt := range marshalTestCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.expr)
+			assert.NilError(t, err)
+			actual, err := Unmarshal(data)
+			assert.NilError(t, err)
+			roundtripped, err := Marshal(actual)
+			assert.NilError(t, err)
+			assert.Equal(t, string(roundtripped), string(data))
+		})
+	}
+}
+
+func TestUnmarshalUnknownType(t *testing.T) {
+	_, err := Unmarshal([]byte(`{"type":"Bogus","data":null}`))
+	assert.ErrorContains(t, err, "unknown expression type")
+}
+
+func TestMarshalText(t *testing.T) {
+	for _, tt := range marshalTestCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := tt.expr.(encoding.TextMarshaler)
+			assert.Assert(t, ok, "%T does not implement encoding.TextMarshaler", tt.expr)
+			text, err := m.MarshalText()
+			assert.NilError(t, err)
+			actual, err := Unmarshal(text)
+			assert.NilError(t, err)
+			roundtripped, err := Marshal(actual)
+			assert.NilError(t, err)
+			assert.Equal(t, string(roundtripped), string(text))
+		})
+	}
+}
+
+// TestJSONMarshalNoRecursion checks that every concrete type, each of which
+// satisfies both json.Marshaler and encoding.TextMarshaler, encodes through
+// encoding/json without recursing: encoding/json prefers MarshalJSON, but if a type's
+// MarshalJSON were ever accidentally removed while MarshalText remained,
+// encoding/json would silently fall back to MarshalText, which itself calls
+// Marshal/json.Marshal on the same value, recursing until the stack
+// overflows. Calling the stdlib json.Marshal directly (not this package's
+// Marshal) exercises that exact dispatch
+func TestJSONMarshalNoRecursion(t *testing.T) {
+	for _, tt := range marshalTestCases() {
+		if tt.name == "Nested" {
+			continue
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := json.Marshal(tt.expr)
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -6,26 +6,45 @@ import (
 	"github.com/icholy/recurring/timeutil"
 )
 
-// Next finds the next occurence of the temporal expression starting at t
+// Next finds the next occurence of the temporal expression starting at t,
+// delegating to te's own Next so composite expressions jump straight to
+// their answer instead of being scanned minute-by-minute. Expressions that
+// can never be satisfied (e.g. And(Day(31), Month(time.April))) return a
+// zero time once farFuture is reached, rather than searching forever.
 func Next(t time.Time, te TemporalExpression) time.Time {
-	t = timeutil.BeginningOfDay(t)
-	for !te.Includes(t) {
-		t = t.Add(24 * time.Hour)
-	}
-	return t
+	return te.Next(t, farFuture)
 }
 
-// NextN finds the next n occurences of the temportal expression starting at t
+// NextN finds the next n occurences of the temportal expression starting at
+// t. If te has fewer than n remaining occurences before farFuture, the
+// returned slice is shorter than n.
 func NextN(t time.Time, te TemporalExpression, n int) []time.Time {
-	tt := make([]time.Time, n)
+	g := te.Granularity()
+	tt := make([]time.Time, 0, n)
 	for i := 0; i < n; i++ {
-		t = Next(t, te)
-		tt[i] = t
-		t = t.Add(24 * time.Hour)
+		next := te.Next(t, farFuture)
+		if next.IsZero() {
+			break
+		}
+		tt = append(tt, next)
+		t = next.Add(g)
 	}
 	return tt
 }
 
+// minGranularity returns the smallest Granularity among ee, defaulting
+// to a day when ee is empty.
+func minGranularity(ee []TemporalExpression) time.Duration {
+	g := 24 * time.Hour
+	for i, e := range ee {
+		eg := e.Granularity()
+		if i == 0 || eg < g {
+			g = eg
+		}
+	}
+	return g
+}
+
 // TemporalExpression matches a subset of time values
 type TemporalExpression interface {
 
@@ -35,6 +54,11 @@ type TemporalExpression interface {
 	// Next returns the first available time after t that matches the expression
 	// if the resulting value is greater than max, return a zero time
 	Next(t, max time.Time) time.Time
+
+	// Granularity returns the smallest time unit that affects whether
+	// the expression matches, so callers know how finely to step when
+	// searching for the next occurrence
+	Granularity() time.Duration
 }
 
 // Day is a temporal expression that matches a day of the month starting at 1
@@ -57,7 +81,7 @@ func (d Day) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (d Day) Next(t, max time.Time) time.Time {
-	for t.Before(max) {
+	for !t.After(max) {
 		if d.Includes(t) {
 			return t
 		}
@@ -71,6 +95,11 @@ func (d Day) Next(t, max time.Time) time.Time {
 	return time.Time{}
 }
 
+// Granularity returns 24 hours, since a Day only matches whole days
+func (d Day) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Days is a helper function that combines multiple Day temporal
 // expressions with a logical OR operation
 func Days(days ...int) TemporalExpression {
@@ -104,7 +133,7 @@ func (dr DayRangeExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (dr DayRangeExpression) Next(t, max time.Time) time.Time {
-	for t.Before(max) {
+	for !t.After(max) {
 		if dr.Includes(t) {
 			return t
 		}
@@ -113,35 +142,72 @@ func (dr DayRangeExpression) Next(t, max time.Time) time.Time {
 	return time.Time{}
 }
 
-// Week is a temporal expression that matches a week in a month starting at 1
-// negative numbers start at the end of the month and move backwards
-type Week int
+// Granularity returns 24 hours, since a DayRangeExpression only matches whole days
+func (dr DayRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
+// WeekOption configures a WeekExpression or WeekRangeExpression
+type WeekOption func(*WeekExpression)
+
+// WithCalendar sets the Calendar used to compute week boundaries,
+// overriding timeutil.Default. This lets a single program mix Monday-start
+// and Sunday-start week numbering. Next and NextN take no Calendar option of
+// their own: each WeekExpression/WeekRangeExpression carries the Calendar it
+// was built with, so Next/NextN already honor it via Includes/Next without
+// any extra plumbing
+func WithCalendar(c timeutil.Calendar) WeekOption {
+	return func(w *WeekExpression) { w.Calendar = c }
+}
 
-func (w Week) normalize(t time.Time) int {
-	week := int(w)
-	if week < 0 {
-		week = timeutil.WeekOfMonth(timeutil.EndOfMonth(t)) + week + 1
+// Week returns a temporal expression that matches the Nth week of the
+// month starting at 1; negative numbers start at the end of the month
+// and move backwards
+func Week(n int, opts ...WeekOption) WeekExpression {
+	w := WeekExpression{N: n, Calendar: timeutil.Default}
+	for _, opt := range opts {
+		opt(&w)
 	}
-	return week
+	return w
+}
+
+// WeekExpression is a temporal expression that matches week N of the
+// month, where week boundaries are determined by Calendar
+type WeekExpression struct {
+	N        int
+	Calendar timeutil.Calendar
+}
+
+func (w WeekExpression) normalize(t time.Time) int {
+	n := w.N
+	if n < 0 {
+		n = w.Calendar.WeekOfMonth(timeutil.EndOfMonth(t)) + n + 1
+	}
+	return n
 }
 
 // Includes returns true when the provided time's week matches the expression's
-func (w Week) Includes(t time.Time) bool {
-	return timeutil.WeekOfMonth(t) == w.normalize(t)
+func (w WeekExpression) Includes(t time.Time) bool {
+	return w.Calendar.WeekOfMonth(t) == w.normalize(t)
 }
 
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
-func (w Week) Next(t, max time.Time) time.Time {
-	for t.Before(max) {
+func (w WeekExpression) Next(t, max time.Time) time.Time {
+	for !t.After(max) {
 		if w.Includes(t) {
 			return t
 		}
-		t = timeutil.BeginningOfWeek(t.AddDate(0, 0, 7))
+		t = w.Calendar.BeginningOfWeek(t.AddDate(0, 0, 7))
 	}
 	return time.Time{}
 }
 
+// Granularity returns 24 hours, since a WeekExpression only matches whole days
+func (w WeekExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Weeks is a helper function that combines multiple Week temporal
 // expressions with a logical OR operation
 func Weeks(weeks ...int) TemporalExpression {
@@ -152,6 +218,43 @@ func Weeks(weeks ...int) TemporalExpression {
 	return Or(ee...)
 }
 
+// WeekRange returns a temporal expression that matches all weeks between
+// the start and end weeks of the month, inclusive
+func WeekRange(start, end int, opts ...WeekOption) WeekRangeExpression {
+	return WeekRangeExpression{Week(start, opts...), Week(end, opts...)}
+}
+
+// WeekRangeExpression is a temporal expression that matches all weeks
+// between the Start and End values
+type WeekRangeExpression struct {
+	Start WeekExpression
+	End   WeekExpression
+}
+
+// Includes returns true when the provided time's week falls
+// between the range's Start and End values
+func (wr WeekRangeExpression) Includes(t time.Time) bool {
+	w := wr.Start.Calendar.WeekOfMonth(t)
+	return wr.Start.normalize(t) <= w && w <= wr.End.normalize(t)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (wr WeekRangeExpression) Next(t, max time.Time) time.Time {
+	for !t.After(max) {
+		if wr.Includes(t) {
+			return t
+		}
+		t = wr.Start.Next(t, max)
+	}
+	return time.Time{}
+}
+
+// Granularity returns 24 hours, since a WeekRangeExpression only matches whole days
+func (wr WeekRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Weekday is a temporal expression that matches a day of the week
 type Weekday time.Weekday
 
@@ -174,7 +277,7 @@ func (wd Weekday) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (wd Weekday) Next(t, max time.Time) time.Time {
-	for t.Before(max) {
+	for !t.After(max) {
 		if wd.Includes(t) {
 			return t
 		}
@@ -183,6 +286,11 @@ func (wd Weekday) Next(t, max time.Time) time.Time {
 	return time.Time{}
 }
 
+// Granularity returns 24 hours, since a Weekday only matches whole days
+func (wd Weekday) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Weekdays is a helper function that combines multiple Weekday
 // temporal expressions using a local OR operation
 func Weekdays(weekdays ...time.Weekday) TemporalExpression {
@@ -216,7 +324,7 @@ func (wr WeekdayRangeExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (wr WeekdayRangeExpression) Next(t, max time.Time) time.Time {
-	for t.Before(max) {
+	for !t.After(max) {
 		if wr.Includes(t) {
 			return t
 		}
@@ -225,6 +333,11 @@ func (wr WeekdayRangeExpression) Next(t, max time.Time) time.Time {
 	return time.Time{}
 }
 
+// Granularity returns 24 hours, since a WeekdayRangeExpression only matches whole days
+func (wr WeekdayRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Month is a temporal expression which matches a month
 type Month time.Month
 
@@ -252,7 +365,23 @@ func (m Month) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (m Month) Next(t, max time.Time) time.Time {
-	return time.Time{}
+	if m.Includes(t) {
+		return t
+	}
+	year := t.Year()
+	if time.Month(m) < t.Month() {
+		year++
+	}
+	next := time.Date(year, time.Month(m), 1, 0, 0, 0, 0, t.Location())
+	if next.After(max) {
+		return time.Time{}
+	}
+	return next
+}
+
+// Granularity returns 24 hours, since a Month only matches whole days
+func (m Month) Granularity() time.Duration {
+	return 24 * time.Hour
 }
 
 // Months is a helper function that combines multiple Month temporal
@@ -278,17 +407,31 @@ type MonthRangeExpression struct {
 	End   time.Month
 }
 
+// Includes returns true when the provided time's month falls
+// between the range's Start and Stop values
+func (mr MonthRangeExpression) Includes(t time.Time) bool {
+	m := t.Month()
+	return mr.Start <= m && m <= mr.End
+}
+
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (mr MonthRangeExpression) Next(t, max time.Time) time.Time {
+	for t.Before(max) || t.Equal(max) {
+		if mr.Includes(t) {
+			return t
+		}
+		t = Month(mr.Start).Next(t, max)
+		if t.IsZero() {
+			return time.Time{}
+		}
+	}
 	return time.Time{}
 }
 
-// Includes returns true when the provided time's month falls
-// between the range's Start and Stop values
-func (mr MonthRangeExpression) Includes(t time.Time) bool {
-	m := t.Month()
-	return mr.Start <= m && m <= mr.End
+// Granularity returns 24 hours, since a MonthRangeExpression only matches whole days
+func (mr MonthRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
 }
 
 // Year is a temporal expression which matchese a year
@@ -303,7 +446,19 @@ func (y Year) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (y Year) Next(t, max time.Time) time.Time {
-	return time.Time{}
+	if y.Includes(t) {
+		return t
+	}
+	next := time.Date(int(y), time.January, 1, 0, 0, 0, 0, t.Location())
+	if next.Before(t) || next.After(max) {
+		return time.Time{}
+	}
+	return next
+}
+
+// Granularity returns 24 hours, since a Year only matches whole days
+func (y Year) Granularity() time.Duration {
+	return 24 * time.Hour
 }
 
 // Years is a helper function that combines multipe Year
@@ -339,9 +494,23 @@ func (yr YearRangeExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (yr YearRangeExpression) Next(t, max time.Time) time.Time {
+	for t.Before(max) || t.Equal(max) {
+		if yr.Includes(t) {
+			return t
+		}
+		t = yr.Start.Next(t, max)
+		if t.IsZero() {
+			return time.Time{}
+		}
+	}
 	return time.Time{}
 }
 
+// Granularity returns 24 hours, since a YearRangeExpression only matches whole days
+func (yr YearRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Date is temporal function that matches the year, month, and day
 type Date time.Time
 
@@ -356,7 +525,16 @@ func (d Date) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (d Date) Next(t, max time.Time) time.Time {
-	return time.Time{}
+	dt := time.Time(d)
+	if dt.Before(t) || dt.After(max) {
+		return time.Time{}
+	}
+	return dt
+}
+
+// Granularity returns 24 hours, since a Date only matches a single whole day
+func (d Date) Granularity() time.Duration {
+	return 24 * time.Hour
 }
 
 // Dates is a helper function that combines multiple Date temporal
@@ -369,6 +547,42 @@ func Dates(dates ...time.Time) TemporalExpression {
 	return Or(ee...)
 }
 
+// DateRange returns a temporal expression that matches every day between
+// the start and end dates, inclusive
+func DateRange(start, end time.Time) DateRangeExpression {
+	return DateRangeExpression{timeutil.BeginningOfDay(start), timeutil.EndOfDay(end)}
+}
+
+// DateRangeExpression is a temporal expression that matches all days
+// between Start and End, inclusive
+type DateRangeExpression struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Includes returns true when the provided time falls between the
+// range's Start and End values
+func (dr DateRangeExpression) Includes(t time.Time) bool {
+	return !t.Before(dr.Start) && !t.After(dr.End)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (dr DateRangeExpression) Next(t, max time.Time) time.Time {
+	if t.Before(dr.Start) {
+		t = dr.Start
+	}
+	if t.After(dr.End) || t.After(max) {
+		return time.Time{}
+	}
+	return t
+}
+
+// Granularity returns 24 hours, since a DateRangeExpression only matches whole days
+func (dr DateRangeExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
 // Or combines multiple temporal expressions into one using
 // a local Or operation
 func Or(ee ...TemporalExpression) OrExpression {
@@ -400,7 +614,23 @@ func (oe OrExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (oe OrExpression) Next(t, max time.Time) time.Time {
-	return time.Time{}
+	var next time.Time
+	for _, e := range oe.ee {
+		n := e.Next(t, max)
+		if n.IsZero() {
+			continue
+		}
+		if next.IsZero() || n.Before(next) {
+			next = n
+		}
+	}
+	return next
+}
+
+// Granularity returns the smallest Granularity among the underlying
+// temporal expressions
+func (oe OrExpression) Granularity() time.Duration {
+	return minGranularity(oe.ee)
 }
 
 // And combines multiple temporal expressions into one using
@@ -434,7 +664,36 @@ func (ae AndExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (ae AndExpression) Next(t, max time.Time) time.Time {
-	return time.Time{}
+	for {
+		if t.After(max) {
+			return time.Time{}
+		}
+		if ae.Includes(t) {
+			return t
+		}
+		next := t
+		for _, e := range ae.ee {
+			n := e.Next(t, max)
+			if n.IsZero() {
+				return time.Time{}
+			}
+			if n.After(next) {
+				next = n
+			}
+		}
+		if next.Equal(t) {
+			// every child reports t itself as its next match, yet t is not
+			// included, so no progress can be made
+			return time.Time{}
+		}
+		t = next
+	}
+}
+
+// Granularity returns the smallest Granularity among the underlying
+// temporal expressions
+func (ae AndExpression) Granularity() time.Duration {
+	return minGranularity(ae.ee)
 }
 
 // Not negates a temporal expression
@@ -457,5 +716,324 @@ func (ne NotExpression) Includes(t time.Time) bool {
 // Next returns the first available time after t that matches the expression
 // if the resulting value is greater than max, return a zero time
 func (ne NotExpression) Next(t, max time.Time) time.Time {
+	g := ne.Granularity()
+	for !t.After(max) {
+		if ne.Includes(t) {
+			return t
+		}
+		t = t.Add(g)
+	}
+	return time.Time{}
+}
+
+// Granularity returns the underlying expression's Granularity
+func (ne NotExpression) Granularity() time.Duration {
+	return ne.e.Granularity()
+}
+
+// HourOfDay is a temporal expression that matches an hour of the day, in [0, 23]
+type HourOfDay int
+
+// Includes returns true when the provided time's hour matches the expression's
+func (h HourOfDay) Includes(t time.Time) bool {
+	return t.Hour() == int(h)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (h HourOfDay) Next(t, max time.Time) time.Time {
+	for !t.After(max) {
+		if h.Includes(t) {
+			return t
+		}
+		t = t.Add(time.Hour)
+	}
+	return time.Time{}
+}
+
+// Granularity returns 1 hour, since an HourOfDay only matches whole hours
+func (h HourOfDay) Granularity() time.Duration {
+	return time.Hour
+}
+
+// MinuteOfHour is a temporal expression that matches a minute of the hour, in [0, 59]
+type MinuteOfHour int
+
+// Includes returns true when the provided time's minute matches the expression's
+func (m MinuteOfHour) Includes(t time.Time) bool {
+	return t.Minute() == int(m)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (m MinuteOfHour) Next(t, max time.Time) time.Time {
+	for !t.After(max) {
+		if m.Includes(t) {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
 	return time.Time{}
 }
+
+// Granularity returns 1 minute, since a MinuteOfHour only matches whole minutes
+func (m MinuteOfHour) Granularity() time.Duration {
+	return time.Minute
+}
+
+// TimeOfDay returns a temporal expression that matches the given hour and
+// minute of the day, e.g. TimeOfDay(9, 30) matches 09:30
+func TimeOfDay(hour, minute int) TemporalExpression {
+	return And(HourOfDay(hour), MinuteOfHour(minute))
+}
+
+// monthsBetween returns the number of whole months between a and b
+func monthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+}
+
+// weekdayOffset returns wd's zero-based offset from the start of the week,
+// with Monday as the first day
+func weekdayOffset(wd time.Weekday) int {
+	if wd == time.Sunday {
+		return 6
+	}
+	return int(wd) - 1
+}
+
+// EveryNDays returns a temporal expression that matches every Nth day
+// starting at, and including, start
+func EveryNDays(start time.Time, n int) EveryNDaysExpression {
+	return EveryNDaysExpression{timeutil.BeginningOfDay(start), n}
+}
+
+// EveryNDaysExpression is a temporal expression that matches every N days
+// counted from Start
+type EveryNDaysExpression struct {
+	Start time.Time
+	N     int
+}
+
+// Includes returns true when the provided time falls exactly a multiple
+// of N days after Start
+func (e EveryNDaysExpression) Includes(t time.Time) bool {
+	t = timeutil.BeginningOfDay(t)
+	if t.Before(e.Start) {
+		return false
+	}
+	days := int(t.Sub(e.Start).Hours() / 24)
+	return days%e.N == 0
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (e EveryNDaysExpression) Next(t, max time.Time) time.Time {
+	if t.Before(e.Start) {
+		t = e.Start
+	}
+	days := int(t.Sub(e.Start).Hours() / 24)
+	if rem := days % e.N; rem != 0 {
+		t = t.AddDate(0, 0, e.N-rem)
+	}
+	if t.After(max) {
+		return time.Time{}
+	}
+	return t
+}
+
+// Granularity returns 24 hours, since an EveryNDaysExpression only matches whole days
+func (e EveryNDaysExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
+// EveryNWeeks returns a temporal expression that matches start's weekday
+// every Nth week, counted from the week containing start
+func EveryNWeeks(start time.Time, n int) EveryNWeeksExpression {
+	return EveryNWeeksExpression{timeutil.BeginningOfDay(start), n}
+}
+
+// EveryNWeeksExpression is a temporal expression that matches Start's
+// weekday every N weeks counted from Start's week
+type EveryNWeeksExpression struct {
+	Start time.Time
+	N     int
+}
+
+// Includes returns true when the provided time is Start's weekday in a
+// week that is a multiple of N weeks after Start's week
+func (e EveryNWeeksExpression) Includes(t time.Time) bool {
+	if t.Weekday() != e.Start.Weekday() {
+		return false
+	}
+	wt := timeutil.BeginningOfWeek(t)
+	ws := timeutil.BeginningOfWeek(e.Start)
+	if wt.Before(ws) {
+		return false
+	}
+	weeks := int(wt.Sub(ws).Hours() / (24 * 7))
+	return weeks%e.N == 0
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (e EveryNWeeksExpression) Next(t, max time.Time) time.Time {
+	if t.Before(e.Start) {
+		t = e.Start
+	}
+	ws := timeutil.BeginningOfWeek(e.Start)
+	offset := weekdayOffset(e.Start.Weekday())
+	for week := timeutil.BeginningOfWeek(t); ; week = week.AddDate(0, 0, 7) {
+		if week.After(max) {
+			return time.Time{}
+		}
+		weeks := int(week.Sub(ws).Hours() / (24 * 7))
+		if weeks%e.N != 0 {
+			continue
+		}
+		candidate := week.AddDate(0, 0, offset)
+		if candidate.Before(t) {
+			continue
+		}
+		if candidate.After(max) {
+			return time.Time{}
+		}
+		return candidate
+	}
+}
+
+// Granularity returns 24 hours, since an EveryNWeeksExpression only matches whole days
+func (e EveryNWeeksExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
+// EveryNMonths returns a temporal expression that matches start's day of
+// the month every Nth month, counted from start's month. Short months clamp
+// to their last day, so "the 31st every 2 months" still fires on Feb 28
+func EveryNMonths(start time.Time, n int) EveryNMonthsExpression {
+	return EveryNMonthsExpression{start, n}
+}
+
+// EveryNMonthsExpression is a temporal expression that matches Start's day
+// of the month every N months counted from Start's month
+type EveryNMonthsExpression struct {
+	Start time.Time
+	N     int
+}
+
+// targetDay returns the day of the month to match in t's month, clamping
+// Start's day to the last day of short months
+func (e EveryNMonthsExpression) targetDay(t time.Time) int {
+	day := e.Start.Day()
+	if last := timeutil.EndOfMonth(t).Day(); day > last {
+		day = last
+	}
+	return day
+}
+
+// Includes returns true when the provided time falls on the target day
+// of a month that is a multiple of N months after Start's month
+func (e EveryNMonthsExpression) Includes(t time.Time) bool {
+	if t.Before(timeutil.BeginningOfDay(e.Start)) {
+		return false
+	}
+	months := monthsBetween(timeutil.BeginningOfMonth(e.Start), timeutil.BeginningOfMonth(t))
+	return months%e.N == 0 && t.Day() == e.targetDay(t)
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (e EveryNMonthsExpression) Next(t, max time.Time) time.Time {
+	if t.Before(e.Start) {
+		t = timeutil.BeginningOfDay(e.Start)
+	}
+	for month := timeutil.BeginningOfMonth(t); ; month = month.AddDate(0, 1, 0) {
+		if month.After(max) {
+			return time.Time{}
+		}
+		months := monthsBetween(timeutil.BeginningOfMonth(e.Start), month)
+		if months%e.N != 0 {
+			continue
+		}
+		candidate := month.AddDate(0, 0, e.targetDay(month)-1)
+		if candidate.Before(t) {
+			continue
+		}
+		if candidate.After(max) {
+			return time.Time{}
+		}
+		return candidate
+	}
+}
+
+// Granularity returns 24 hours, since an EveryNMonthsExpression only matches whole days
+func (e EveryNMonthsExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
+
+// EveryNYears returns a temporal expression that matches start's month and
+// day every Nth year, counted from start's year. Feb 29 clamps to Feb 28
+// in non-leap years
+func EveryNYears(start time.Time, n int) EveryNYearsExpression {
+	return EveryNYearsExpression{start, n}
+}
+
+// EveryNYearsExpression is a temporal expression that matches Start's
+// month and day every N years counted from Start's year
+type EveryNYearsExpression struct {
+	Start time.Time
+	N     int
+}
+
+// targetDate returns the date to match in the given year, clamping Start's
+// day to the last day of Start's month in that year
+func (e EveryNYearsExpression) targetDate(year int) time.Time {
+	first := time.Date(year, e.Start.Month(), 1, 0, 0, 0, 0, e.Start.Location())
+	day := e.Start.Day()
+	if last := timeutil.EndOfMonth(first).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// Includes returns true when the provided time falls on the target date
+// of a year that is a multiple of N years after Start's year
+func (e EveryNYearsExpression) Includes(t time.Time) bool {
+	if t.Before(timeutil.BeginningOfDay(e.Start)) {
+		return false
+	}
+	years := t.Year() - e.Start.Year()
+	if years%e.N != 0 {
+		return false
+	}
+	target := e.targetDate(t.Year())
+	return t.Year() == target.Year() && t.Month() == target.Month() && t.Day() == target.Day()
+}
+
+// Next returns the first available time after t that matches the expression
+// if the resulting value is greater than max, return a zero time
+func (e EveryNYearsExpression) Next(t, max time.Time) time.Time {
+	if t.Before(e.Start) {
+		t = timeutil.BeginningOfDay(e.Start)
+	}
+	for year := t.Year(); ; year++ {
+		if time.Date(year, time.January, 1, 0, 0, 0, 0, e.Start.Location()).After(max) {
+			return time.Time{}
+		}
+		if (year-e.Start.Year())%e.N != 0 {
+			continue
+		}
+		candidate := e.targetDate(year)
+		if candidate.Before(t) {
+			continue
+		}
+		if candidate.After(max) {
+			return time.Time{}
+		}
+		return candidate
+	}
+}
+
+// Granularity returns 24 hours, since an EveryNYearsExpression only matches whole days
+func (e EveryNYearsExpression) Granularity() time.Duration {
+	return 24 * time.Hour
+}
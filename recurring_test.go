@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/icholy/recurring/timeutil"
 	"gotest.tools/assert"
 )
 
@@ -66,6 +67,66 @@ func TestNext(t *testing.T) {
 			input:    "2012/01/06",
 			expected: "2012/02/02",
 		},
+		{
+			name:     "Month/After",
+			expr:     March,
+			input:    "2012/01/06",
+			expected: "2012/03/01",
+		},
+		{
+			name:     "Month/Same",
+			expr:     January,
+			input:    "2012/01/06",
+			expected: "2012/01/06",
+		},
+		{
+			name:     "Month/Before",
+			expr:     January,
+			input:    "2012/03/06",
+			expected: "2013/01/01",
+		},
+		{
+			name:     "MonthRange/After",
+			expr:     MonthRange(time.March, time.May),
+			input:    "2012/01/06",
+			expected: "2012/03/01",
+		},
+		{
+			name:     "Year/Same",
+			expr:     Year(2012),
+			input:    "2012/06/06",
+			expected: "2012/06/06",
+		},
+		{
+			name:     "YearRange/After",
+			expr:     YearRange(2013, 2015),
+			input:    "2012/06/06",
+			expected: "2013/01/01",
+		},
+		{
+			name:     "Date",
+			expr:     Date(mustParseDate(layout, "2012/06/06")),
+			input:    "2012/01/01",
+			expected: "2012/06/06",
+		},
+		{
+			name:     "Or",
+			expr:     Or(Day(20), Month(time.March)),
+			input:    "2012/01/06",
+			expected: "2012/01/20",
+		},
+		{
+			name:     "And",
+			expr:     And(Day(1), Month(time.March)),
+			input:    "2012/01/06",
+			expected: "2012/03/01",
+		},
+		{
+			name:     "Not",
+			expr:     Not(MonthRange(time.January, time.February)),
+			input:    "2012/01/06",
+			expected: "2012/03/01",
+		},
 	}
 
 	for _, tt := range tests {
@@ -79,6 +140,55 @@ func TestNext(t *testing.T) {
 	}
 }
 
+// TestNextInclusiveMax guards against Next treating max as an exclusive
+// upper bound, which would silently drop a match landing exactly on max
+func TestNextInclusiveMax(t *testing.T) {
+	layout := "2006/01/02"
+
+	tests := []struct {
+		name string
+		expr TemporalExpression
+		at   string
+	}{
+		{"Day", Day(15), "2018/01/15"},
+		{"DayRange", DayRange(10, 15), "2018/01/15"},
+		{"Week", Week(1), "2018/01/07"},
+		{"WeekRange", WeekRange(1, 2), "2018/01/14"},
+		{"Weekday", Monday, "2018/01/15"},
+		{"WeekdayRange", WeekdayRange(time.Monday, time.Friday), "2018/01/15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := mustParseDate(layout, tt.at)
+			assert.Assert(t, tt.expr.Includes(at))
+			actual := tt.expr.Next(at, at)
+			assert.Assert(t, !actual.IsZero())
+			assert.Equal(t, actual, at)
+		})
+	}
+}
+
+// TestNextUnsatisfiable guards against Next/NextN scanning forever when te
+// can never match, e.g. a day of the month that doesn't occur in a given
+// month
+func TestNextUnsatisfiable(t *testing.T) {
+	start := mustParseDate("2006/01/02", "2018/01/01")
+	expr := And(Day(31), Month(time.April))
+
+	done := make(chan time.Time, 1)
+	go func() { done <- Next(start, expr) }()
+	select {
+	case actual := <-done:
+		assert.Assert(t, actual.IsZero())
+	case <-time.After(2 * time.Second):
+		t.Fatal("Next did not terminate")
+	}
+
+	tt := NextN(start, expr, 3)
+	assert.Equal(t, len(tt), 0)
+}
+
 func TestIncludes(t *testing.T) {
 
 	// yyyy/mm/dd
@@ -179,6 +289,24 @@ func TestIncludes(t *testing.T) {
 			match:   []string{"2018/11/02", "2018/12/03", "2018/03/04"},
 			nomatch: []string{"2018/01/02", "2018/01/03", "2018/02/04"},
 		},
+		{
+			name:    "WeekRange",
+			expr:    WeekRange(1, 2),
+			match:   []string{"2018/10/01", "2018/10/07", "2018/10/08", "2018/10/14"},
+			nomatch: []string{"2018/10/15", "2018/10/28"},
+		},
+		{
+			name:    "Week/SundayStart",
+			expr:    Week(1, WithCalendar(timeutil.Calendar{WeekStart: time.Sunday})),
+			match:   []string{"2018/10/01", "2018/10/06"},
+			nomatch: []string{"2018/10/07"},
+		},
+		{
+			name:    "DateRange",
+			expr:    DateRange(mustParseDate("2006/01/02", "2018/10/05"), mustParseDate("2006/01/02", "2018/10/10")),
+			match:   []string{"2018/10/05", "2018/10/07", "2018/10/10"},
+			nomatch: []string{"2018/10/04", "2018/10/11"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -196,3 +324,157 @@ func TestIncludes(t *testing.T) {
 		})
 	}
 }
+
+func TestEveryN(t *testing.T) {
+
+	// yyyy/mm/dd
+	layout := "2006/01/02"
+
+	tests := []struct {
+		name    string
+		expr    TemporalExpression
+		match   []string
+		nomatch []string
+	}{
+		{
+			name:    "EveryNDays",
+			expr:    EveryNDays(mustParseDate(layout, "2018/01/01"), 3),
+			match:   []string{"2018/01/01", "2018/01/04", "2018/01/10"},
+			nomatch: []string{"2018/01/02", "2018/01/03", "2017/12/29"},
+		},
+		{
+			name:    "EveryNWeeks",
+			expr:    EveryNWeeks(mustParseDate(layout, "2018/01/01"), 2), // Monday
+			match:   []string{"2018/01/01", "2018/01/15", "2018/01/29"},
+			nomatch: []string{"2018/01/08", "2018/01/02", "2018/01/16"},
+		},
+		{
+			name:    "EveryNMonths",
+			expr:    EveryNMonths(mustParseDate(layout, "2018/01/31"), 2),
+			match:   []string{"2018/01/31", "2018/03/31", "2018/05/31"},
+			nomatch: []string{"2018/02/28", "2018/04/30", "2018/01/30"},
+		},
+		{
+			name:    "EveryNMonths/ShortMonthClamps",
+			expr:    EveryNMonths(mustParseDate(layout, "2018/01/31"), 1),
+			match:   []string{"2018/01/31", "2018/02/28", "2018/03/31"},
+			nomatch: []string{"2018/02/27"},
+		},
+		{
+			name:    "EveryNYears",
+			expr:    EveryNYears(mustParseDate(layout, "2016/02/29"), 4),
+			match:   []string{"2016/02/29", "2020/02/29"},
+			nomatch: []string{"2018/02/28", "2017/02/28"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, st := range tt.match {
+				mt := mustParseDate(layout, st)
+				assert.Assert(t, tt.expr.Includes(mt), st)
+			}
+			for _, st := range tt.nomatch {
+				mt := mustParseDate(layout, st)
+				assert.Assert(t, !tt.expr.Includes(mt), st)
+			}
+		})
+	}
+}
+
+func TestEveryNNext(t *testing.T) {
+
+	// yyyy/mm/dd
+	layout := "2006/01/02"
+
+	tests := []struct {
+		name     string
+		expr     TemporalExpression
+		input    string
+		expected string
+	}{
+		{
+			name:     "EveryNDays",
+			expr:     EveryNDays(mustParseDate(layout, "2018/01/01"), 3),
+			input:    "2018/01/02",
+			expected: "2018/01/04",
+		},
+		{
+			name:     "EveryNMonths/ShortMonthClamps",
+			expr:     EveryNMonths(mustParseDate(layout, "2018/01/31"), 1),
+			input:    "2018/02/01",
+			expected: "2018/02/28",
+		},
+		{
+			name:     "EveryNYears",
+			expr:     EveryNYears(mustParseDate(layout, "2016/02/29"), 4),
+			input:    "2017/01/01",
+			expected: "2020/02/29",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := mustParseDate(layout, tt.input)
+			actual := tt.expr.Next(input, input.AddDate(10, 0, 0))
+			assert.Assert(t, !actual.IsZero())
+			assert.Equal(t, actual.Format(layout), tt.expected)
+		})
+	}
+}
+
+func mustParseDate(layout, s string) time.Time {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestTimeOfDay(t *testing.T) {
+
+	// yyyy/mm/dd 15:04
+	layout := "2006/01/02 15:04"
+
+	tests := []struct {
+		name     string
+		expr     TemporalExpression
+		input    string
+		expected string
+	}{
+		{
+			name:     "HourOfDay/After",
+			expr:     HourOfDay(9),
+			input:    "2012/01/01 00:00",
+			expected: "2012/01/01 09:00",
+		},
+		{
+			name:     "MinuteOfHour/After",
+			expr:     MinuteOfHour(30),
+			input:    "2012/01/01 09:00",
+			expected: "2012/01/01 09:30",
+		},
+		{
+			name:     "TimeOfDay/After",
+			expr:     TimeOfDay(9, 30),
+			input:    "2012/01/01 00:00",
+			expected: "2012/01/01 09:30",
+		},
+		{
+			name:     "TimeOfDay/NextDay",
+			expr:     TimeOfDay(9, 30),
+			input:    "2012/01/01 10:00",
+			expected: "2012/01/02 09:30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := time.Parse(layout, tt.input)
+			assert.NilError(t, err)
+			actual := Next(input, tt.expr)
+			assert.Assert(t, !actual.IsZero())
+			assert.Equal(t, actual.Format(layout), tt.expected)
+		})
+	}
+}
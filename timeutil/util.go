@@ -15,15 +15,23 @@ func BeginningOfDay(t time.Time) time.Time {
 	return BeginningOfHour(t).Add(d)
 }
 
-func BeginningOfWeek(t time.Time) time.Time {
+// Calendar configures week-related calculations, primarily which weekday
+// is treated as the start of the week.
+type Calendar struct {
+	WeekStart time.Weekday
+}
+
+// Default is the Calendar used by the package-level week helpers below.
+var Default = Calendar{WeekStart: time.Monday}
+
+func (c Calendar) BeginningOfWeek(t time.Time) time.Time {
 	t = BeginningOfDay(t)
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	weekday = weekday - 1
-	d := time.Duration(-weekday) * 24 * time.Hour
-	return t.Add(d)
+	offset := (int(t.Weekday()) - int(c.WeekStart) + 7) % 7
+	return t.AddDate(0, 0, -offset)
+}
+
+func BeginningOfWeek(t time.Time) time.Time {
+	return Default.BeginningOfWeek(t)
 }
 
 func BeginningOfMonth(t time.Time) time.Time {
@@ -56,8 +64,12 @@ func EndOfDay(t time.Time) time.Time {
 	return BeginningOfDay(t).Add(24*time.Hour - time.Nanosecond)
 }
 
+func (c Calendar) EndOfWeek(t time.Time) time.Time {
+	return c.BeginningOfWeek(t).AddDate(0, 0, 7).Add(-time.Nanosecond)
+}
+
 func EndOfWeek(t time.Time) time.Time {
-	return BeginningOfWeek(t).AddDate(0, 0, 7).Add(-time.Nanosecond)
+	return Default.EndOfWeek(t)
 }
 
 func EndOfMonth(t time.Time) time.Time {
@@ -72,33 +84,15 @@ func EndOfYear(t time.Time) time.Time {
 	return BeginningOfYear(t).AddDate(1, 0, 0).Add(-time.Nanosecond)
 }
 
-func Monday(t time.Time) time.Time {
-	t = BeginningOfDay(t)
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	d := time.Duration(-weekday+1) * 24 * time.Hour
-	return t.Truncate(time.Hour).Add(d)
-}
-
-func Sunday(t time.Time) time.Time {
-	t = BeginningOfDay(t)
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		return t
-	} else {
-		d := time.Duration(7-weekday) * 24 * time.Hour
-		return t.Truncate(time.Hour).Add(d)
-	}
-}
-
-func EndOfSunday(t time.Time) time.Time {
-	return Sunday(t).Add(24*time.Hour - time.Nanosecond)
+// WeekOfMonth returns the 1-based index of the Calendar week containing t
+// within t's month, so the week containing the 1st is always week 1.
+func (c Calendar) WeekOfMonth(t time.Time) int {
+	firstWeek := c.BeginningOfWeek(BeginningOfMonth(t))
+	thisWeek := c.BeginningOfWeek(t)
+	days := int(thisWeek.Sub(firstWeek).Hours() / 24)
+	return 1 + days/7
 }
 
 func WeekOfMonth(t time.Time) int {
-	_, firstWeek := BeginningOfMonth(t).ISOWeek()
-	_, thisWeek := t.ISOWeek()
-	return 1 + thisWeek - firstWeek
+	return Default.WeekOfMonth(t)
 }
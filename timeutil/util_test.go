@@ -0,0 +1,65 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestWeekOfMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		input    string
+		expected int
+	}{
+		{
+			name:     "Monday start, first week",
+			calendar: Default,
+			input:    "2018/10/01", // Monday
+			expected: 1,
+		},
+		{
+			name:     "Monday start, second week",
+			calendar: Default,
+			input:    "2018/10/08", // Monday
+			expected: 2,
+		},
+		{
+			name:     "Sunday start, first week",
+			calendar: Calendar{WeekStart: time.Sunday},
+			input:    "2018/10/01", // Monday, but the 1st's Sunday-starting week
+			expected: 1,
+		},
+		{
+			name:     "Sunday start, second week",
+			calendar: Calendar{WeekStart: time.Sunday},
+			input:    "2018/10/07", // Sunday, starts week 2
+			expected: 2,
+		},
+		{
+			name:     "year boundary",
+			calendar: Default,
+			input:    "2016/01/01", // Friday, still week 1 of January
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := time.Parse("2006/01/02", tt.input)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.calendar.WeekOfMonth(input), tt.expected)
+		})
+	}
+}
+
+func TestBeginningOfWeek(t *testing.T) {
+	input, err := time.Parse("2006/01/02", "2018/10/03") // Wednesday
+	assert.NilError(t, err)
+
+	assert.Equal(t, Default.BeginningOfWeek(input).Format("2006/01/02"), "2018/10/01")
+	sunday := Calendar{WeekStart: time.Sunday}
+	assert.Equal(t, sunday.BeginningOfWeek(input).Format("2006/01/02"), "2018/09/30")
+}